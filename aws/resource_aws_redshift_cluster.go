@@ -0,0 +1,1502 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsRedshiftCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftClusterCreate,
+		Read:   resourceAwsRedshiftClusterRead,
+		Update: resourceAwsRedshiftClusterUpdate,
+		Delete: resourceAwsRedshiftClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("skip_final_snapshot", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsRedshiftClusterValidateAvailabilityZoneRelocation,
+			resourceAwsRedshiftClusterValidateRestoreFromSnapshot,
+			// Without AZ relocation a cluster can't move in place, so an
+			// availability_zone change replaces it.
+			customdiff.ForceNewIf("availability_zone", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return !d.Get("availability_zone_relocation_enabled").(bool)
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-z0-9_$]+$`), "must contain only lowercase alphanumeric characters, underscores, and dollar signs"),
+					validation.StringMatch(regexp.MustCompile(`^[a-z]`), "first character must be a letter"),
+				),
+			},
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("only lowercase alphanumeric characters and hyphens allowed in %q", k))
+					}
+					if !regexp.MustCompile(`^[a-z]`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("first character of %q must be a letter", k))
+					}
+					if regexp.MustCompile(`--`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("%q cannot contain two consecutive hyphens", k))
+					}
+					if regexp.MustCompile(`-$`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("%q cannot end with a hyphen", k))
+					}
+					return
+				},
+			},
+			"cluster_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "single-node",
+				ValidateFunc: validation.StringInSlice([]string{
+					"single-node",
+					"multi-node",
+				}, false),
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"master_username": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if !regexp.MustCompile(`^[a-z][a-z0-9_+.@-]*$`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("%q must begin with a letter and contain only lowercase alphanumeric characters", k))
+					}
+					return
+				},
+			},
+			"snapshot_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"snapshot_cluster_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"owner_account": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"master_password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_user_password"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// A cluster restored from a snapshot never learns the master
+					// password, so don't force a diff until the user actually sets
+					// one. Once they configure a value, let it through so it's
+					// applied (and can be rotated) like any other cluster.
+					return old == "" && new == "" && d.Get("snapshot_identifier").(string) != ""
+				},
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if !regexp.MustCompile(`^.*[a-z].*`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("%q must contain at least one lowercase letter", k))
+					}
+					if !regexp.MustCompile(`^.*[A-Z].*`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("%q must contain at least one uppercase letter", k))
+					}
+					if !regexp.MustCompile(`^.*[0-9].*`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("%q must contain at least one number", k))
+					}
+					if len(value) < 8 {
+						errors = append(errors, fmt.Errorf("%q must be at least 8 characters", k))
+					}
+					return
+				},
+			},
+			"manage_master_user_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"master_password"},
+			},
+			"master_password_secret_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"master_password_secret_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"cluster_subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					return strings.ToLower(val.(string))
+				},
+			},
+			"cluster_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"automated_snapshot_retention_period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5439,
+				ForceNew: true,
+			},
+			"cluster_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1.0",
+			},
+			"allow_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"number_of_nodes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enhanced_vpc_routing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"availability_zone_relocation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"available",
+					"paused",
+				}, false),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"elastic_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"final_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if !regexp.MustCompile(`^[0-9A-Za-z-]+$`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("only alphanumeric characters and hyphens allowed in %q", k))
+					}
+					if regexp.MustCompile(`--`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("%q cannot contain two consecutive hyphens", k))
+					}
+					if regexp.MustCompile(`-$`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("%q cannot end with a hyphen", k))
+					}
+					return
+				},
+			},
+			"skip_final_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cluster_public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_revision_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iam_roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"logging": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"s3_key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"snapshot_copy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"retention_period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  7,
+						},
+						"manual_snapshot_retention_period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.IntBetween(-1, 3653),
+						},
+						"grant_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"snapshot_schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schedule_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"deferred_maintenance_window": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"defer_maintenance_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"defer_maintenance_start_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"defer_maintenance_end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+			"cluster_nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRedshiftClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	clusterID := d.Get("cluster_identifier").(string)
+
+	if v, ok := d.GetOk("snapshot_identifier"); ok {
+		if err := resourceAwsRedshiftClusterRestoreFromSnapshot(d, conn, clusterID, v.(string)); err != nil {
+			return err
+		}
+	} else {
+		if err := resourceAwsRedshiftClusterCreateNew(d, conn, clusterID); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(clusterID)
+
+	log.Printf("[INFO] Redshift Cluster ID: %s", d.Id())
+
+	if err := waitForRedshiftClusterCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Redshift Cluster (%s) creation: %w", d.Id(), err)
+	}
+
+	if _, ok := d.GetOk("snapshot_copy"); ok {
+		if err := enableRedshiftClusterSnapshotCopy(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("logging"); ok {
+		loggingConfig := v.([]interface{})[0].(map[string]interface{})
+		if loggingConfig["enable"].(bool) {
+			if err := enableRedshiftClusterLogging(conn, d.Id(), loggingConfig); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("snapshot_schedule"); ok {
+		ss := v.([]interface{})[0].(map[string]interface{})
+		if err := modifyRedshiftClusterSnapshotSchedule(conn, d.Id(), ss["schedule_identifier"].(string), false); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("deferred_maintenance_window"); ok {
+		dmw := v.([]interface{})[0].(map[string]interface{})
+		if err := deferRedshiftClusterMaintenance(conn, d.Id(), dmw); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsRedshiftClusterRead(d, meta)
+}
+
+func resourceAwsRedshiftClusterCreateNew(d *schema.ResourceData, conn *redshift.Redshift, clusterID string) error {
+	input := &redshift.CreateClusterInput{
+		ClusterIdentifier:                aws.String(clusterID),
+		ClusterVersion:                   aws.String(d.Get("cluster_version").(string)),
+		NodeType:                         aws.String(d.Get("node_type").(string)),
+		MasterUsername:                   aws.String(d.Get("master_username").(string)),
+		ClusterType:                      aws.String(d.Get("cluster_type").(string)),
+		AllowVersionUpgrade:              aws.Bool(d.Get("allow_version_upgrade").(bool)),
+		AutomatedSnapshotRetentionPeriod: aws.Int64(int64(d.Get("automated_snapshot_retention_period").(int))),
+		Port:                             aws.Int64(int64(d.Get("port").(int))),
+		EnhancedVpcRouting:               aws.Bool(d.Get("enhanced_vpc_routing").(bool)),
+		PubliclyAccessible:               aws.Bool(d.Get("publicly_accessible").(bool)),
+		Encrypted:                        aws.Bool(d.Get("encrypted").(bool)),
+	}
+
+	if v, ok := d.GetOk("database_name"); ok {
+		input.DBName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cluster_subnet_group_name"); ok {
+		input.ClusterSubnetGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cluster_parameter_group_name"); ok {
+		input.ClusterParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("elastic_ip"); ok {
+		input.ElasticIp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("availability_zone_relocation_enabled"); ok {
+		input.AvailabilityZoneRelocation = aws.Bool(v.(bool))
+	}
+
+	if d.Get("manage_master_user_password").(bool) {
+		input.ManageMasterPassword = aws.Bool(true)
+		if v, ok := d.GetOk("master_password_secret_kms_key_id"); ok {
+			input.MasterPasswordSecretKmsKeyId = aws.String(v.(string))
+		}
+	} else {
+		input.MasterUserPassword = aws.String(d.Get("master_password").(string))
+	}
+
+	if v, ok := d.GetOk("cluster_security_groups"); ok {
+		input.ClusterSecurityGroups = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok {
+		input.VpcSecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("iam_roles"); ok {
+		input.IamRoles = expandStringSet(v.(*schema.Set))
+	}
+
+	if d.Get("cluster_type").(string) == "multi-node" {
+		input.NumberOfNodes = aws.Int64(int64(d.Get("number_of_nodes").(int)))
+	}
+
+	if v := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().RedshiftTags(); len(v) > 0 {
+		input.Tags = v
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Cluster: %s", input)
+	_, err := conn.CreateCluster(input)
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Cluster (%s): %w", clusterID, err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftClusterRestoreFromSnapshot(d *schema.ResourceData, conn *redshift.Redshift, clusterID, snapshotID string) error {
+	input := &redshift.RestoreFromClusterSnapshotInput{
+		ClusterIdentifier:   aws.String(clusterID),
+		SnapshotIdentifier:  aws.String(snapshotID),
+		NodeType:            aws.String(d.Get("node_type").(string)),
+		AllowVersionUpgrade: aws.Bool(d.Get("allow_version_upgrade").(bool)),
+		Port:                aws.Int64(int64(d.Get("port").(int))),
+		EnhancedVpcRouting:  aws.Bool(d.Get("enhanced_vpc_routing").(bool)),
+		PubliclyAccessible:  aws.Bool(d.Get("publicly_accessible").(bool)),
+	}
+
+	if v, ok := d.GetOk("snapshot_cluster_identifier"); ok {
+		input.SnapshotClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("owner_account"); ok {
+		input.OwnerAccount = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cluster_subnet_group_name"); ok {
+		input.ClusterSubnetGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cluster_parameter_group_name"); ok {
+		input.ClusterParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("elastic_ip"); ok {
+		input.ElasticIp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("availability_zone_relocation_enabled"); ok {
+		input.AvailabilityZoneRelocation = aws.Bool(v.(bool))
+	}
+
+	if d.Get("manage_master_user_password").(bool) {
+		input.ManageMasterPassword = aws.Bool(true)
+		if v, ok := d.GetOk("master_password_secret_kms_key_id"); ok {
+			input.MasterPasswordSecretKmsKeyId = aws.String(v.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok {
+		input.VpcSecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("iam_roles"); ok {
+		input.IamRoles = expandStringSet(v.(*schema.Set))
+	}
+
+	if d.Get("cluster_type").(string) == "multi-node" {
+		input.NumberOfNodes = aws.Int64(int64(d.Get("number_of_nodes").(int)))
+	}
+
+	log.Printf("[DEBUG] Restoring Redshift Cluster from snapshot: %s", input)
+	_, err := conn.RestoreFromClusterSnapshot(input)
+	if err != nil {
+		return fmt.Errorf("error restoring Redshift Cluster (%s) from snapshot (%s): %w", clusterID, snapshotID, err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	rsc, err := resourceAwsRedshiftClusterRetrieve(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+
+	if rsc == nil {
+		log.Printf("[WARN] Redshift Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("master_username", rsc.MasterUsername)
+	d.Set("node_type", rsc.NodeType)
+	d.Set("allow_version_upgrade", rsc.AllowVersionUpgrade)
+	d.Set("database_name", rsc.DBName)
+	d.Set("cluster_identifier", rsc.ClusterIdentifier)
+	d.Set("cluster_version", rsc.ClusterVersion)
+	d.Set("cluster_revision_number", rsc.ClusterRevisionNumber)
+	d.Set("automated_snapshot_retention_period", rsc.AutomatedSnapshotRetentionPeriod)
+	d.Set("availability_zone", rsc.AvailabilityZone)
+	d.Set("encrypted", rsc.Encrypted)
+	d.Set("enhanced_vpc_routing", rsc.EnhancedVpcRouting)
+	d.Set("kms_key_id", rsc.KmsKeyId)
+	d.Set("publicly_accessible", rsc.PubliclyAccessible)
+	d.Set("port", rsc.Endpoint.Port)
+	d.Set("preferred_maintenance_window", rsc.PreferredMaintenanceWindow)
+	d.Set("cluster_public_key", rsc.ClusterPublicKey)
+	d.Set("cluster_revision_number", rsc.ClusterRevisionNumber)
+	d.Set("availability_zone_relocation_enabled", aws.StringValue(rsc.AvailabilityZoneRelocationStatus) == "enabled")
+
+	if rsc.MasterPasswordSecretArn != nil {
+		d.Set("manage_master_user_password", true)
+		d.Set("master_password_secret_arn", rsc.MasterPasswordSecretArn)
+		d.Set("master_password_secret_kms_key_id", rsc.MasterPasswordSecretKmsKeyId)
+	} else {
+		d.Set("manage_master_user_password", false)
+		d.Set("master_password_secret_arn", "")
+	}
+
+	switch aws.StringValue(rsc.ClusterStatus) {
+	case "paused":
+		d.Set("state", "paused")
+	default:
+		d.Set("state", "available")
+	}
+
+	if rsc.Endpoint != nil && rsc.Endpoint.Address != nil {
+		endpoint := *rsc.Endpoint.Address
+		if rsc.Endpoint.Port != nil {
+			endpoint = fmt.Sprintf("%s:%d", endpoint, *rsc.Endpoint.Port)
+		}
+		d.Set("endpoint", endpoint)
+		d.Set("dns_name", rsc.Endpoint.Address)
+	}
+
+	d.Set("cluster_type", "single-node")
+	if len(rsc.ClusterNodes) > 1 {
+		d.Set("cluster_type", "multi-node")
+	}
+	d.Set("number_of_nodes", len(rsc.ClusterNodes))
+
+	var vpcg []string
+	for _, g := range rsc.VpcSecurityGroups {
+		vpcg = append(vpcg, *g.VpcSecurityGroupId)
+	}
+	if err := d.Set("vpc_security_group_ids", vpcg); err != nil {
+		return fmt.Errorf("error setting vpc_security_group_ids: %w", err)
+	}
+
+	var csg []string
+	for _, g := range rsc.ClusterSecurityGroups {
+		csg = append(csg, *g.ClusterSecurityGroupName)
+	}
+	if err := d.Set("cluster_security_groups", csg); err != nil {
+		return fmt.Errorf("error setting cluster_security_groups: %w", err)
+	}
+
+	var iamRoles []string
+	for _, i := range rsc.IamRoles {
+		iamRoles = append(iamRoles, *i.IamRoleArn)
+	}
+	if err := d.Set("iam_roles", iamRoles); err != nil {
+		return fmt.Errorf("error setting iam_roles: %w", err)
+	}
+
+	if rsc.ClusterSubnetGroupName != nil {
+		d.Set("cluster_subnet_group_name", rsc.ClusterSubnetGroupName)
+	}
+
+	if rsc.ClusterParameterGroups != nil {
+		d.Set("cluster_parameter_group_name", rsc.ClusterParameterGroups[0].ParameterGroupName)
+	}
+
+	if rsc.ElasticIpStatus != nil {
+		d.Set("elastic_ip", rsc.ElasticIpStatus.ElasticIp)
+	}
+
+	if err := d.Set("cluster_nodes", flattenRedshiftClusterNodes(rsc.ClusterNodes)); err != nil {
+		return fmt.Errorf("error setting cluster_nodes: %w", err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "redshift",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("cluster:%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	if err := d.Set("tags", keyvaluetags.RedshiftKeyValueTags(rsc.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	logging, err := conn.DescribeLoggingStatus(&redshift.DescribeLoggingStatusInput{
+		ClusterIdentifier: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Cluster (%s) logging status: %w", d.Id(), err)
+	}
+
+	if aws.BoolValue(logging.LoggingEnabled) {
+		if err := d.Set("logging", flattenRedshiftLoggingStatus(logging)); err != nil {
+			return fmt.Errorf("error setting logging: %w", err)
+		}
+	} else {
+		d.Set("logging", []map[string]interface{}{
+			{"enable": false},
+		})
+	}
+
+	if rsc.ClusterSnapshotCopyStatus != nil {
+		if err := d.Set("snapshot_copy", flattenRedshiftSnapshotCopyStatus(rsc.ClusterSnapshotCopyStatus)); err != nil {
+			return fmt.Errorf("error setting snapshot_copy: %w", err)
+		}
+	} else {
+		d.Set("snapshot_copy", nil)
+	}
+
+	if rsc.ClusterSnapshotScheduleIdentifier != nil {
+		if err := d.Set("snapshot_schedule", []map[string]interface{}{
+			{
+				"identifier":          aws.StringValue(rsc.ClusterSnapshotScheduleIdentifier),
+				"schedule_identifier": aws.StringValue(rsc.ClusterSnapshotScheduleIdentifier),
+			},
+		}); err != nil {
+			return fmt.Errorf("error setting snapshot_schedule: %w", err)
+		}
+	} else {
+		d.Set("snapshot_schedule", nil)
+	}
+
+	if len(rsc.DeferredMaintenanceWindows) > 0 {
+		if err := d.Set("deferred_maintenance_window", flattenRedshiftDeferredMaintenanceWindows(rsc.DeferredMaintenanceWindows)); err != nil {
+			return fmt.Errorf("error setting deferred_maintenance_window: %w", err)
+		}
+	} else {
+		d.Set("deferred_maintenance_window", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if d.HasChange("state") {
+		if err := resourceAwsRedshiftClusterSetState(conn, d); err != nil {
+			return err
+		}
+	}
+
+	// A paused cluster rejects resize/modify/IAM-role/snapshot-copy/logging
+	// API calls, so rather than silently resuming the cluster out from under
+	// the user we refuse those changes and tell them to resume it first.
+	if d.Get("state").(string) == "paused" && !d.HasChange("state") {
+		for _, attr := range []string{
+			"master_password", "cluster_security_groups", "vpc_security_group_ids",
+			"cluster_parameter_group_name", "automated_snapshot_retention_period",
+			"preferred_maintenance_window", "cluster_version", "allow_version_upgrade",
+			"publicly_accessible", "enhanced_vpc_routing", "cluster_type", "node_type",
+			"number_of_nodes", "elastic_ip", "availability_zone", "availability_zone_relocation_enabled",
+			"manage_master_user_password", "master_password_secret_kms_key_id",
+			"iam_roles", "logging", "snapshot_copy", "snapshot_schedule", "deferred_maintenance_window",
+		} {
+			if d.HasChange(attr) {
+				return fmt.Errorf("cannot modify %q while Redshift Cluster (%s) is paused; set state = \"available\" first", attr, d.Id())
+			}
+		}
+	}
+
+	requestUpdate := false
+	log.Printf("[INFO] Building Redshift Modify Cluster Input")
+	req := &redshift.ModifyClusterInput{
+		ClusterIdentifier: aws.String(d.Id()),
+	}
+
+	if d.HasChange("master_password") && !d.Get("manage_master_user_password").(bool) {
+		req.MasterUserPassword = aws.String(d.Get("master_password").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("manage_master_user_password") || d.HasChange("master_password_secret_kms_key_id") {
+		req.ManageMasterPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
+		if v, ok := d.GetOk("master_password_secret_kms_key_id"); ok {
+			req.MasterPasswordSecretKmsKeyId = aws.String(v.(string))
+		}
+		if !d.Get("manage_master_user_password").(bool) {
+			req.MasterUserPassword = aws.String(d.Get("master_password").(string))
+		}
+		requestUpdate = true
+	}
+
+	if d.HasChange("cluster_security_groups") {
+		req.ClusterSecurityGroups = expandStringSet(d.Get("cluster_security_groups").(*schema.Set))
+		requestUpdate = true
+	}
+
+	if d.HasChange("vpc_security_group_ids") {
+		req.VpcSecurityGroupIds = expandStringSet(d.Get("vpc_security_group_ids").(*schema.Set))
+		requestUpdate = true
+	}
+
+	if d.HasChange("cluster_parameter_group_name") {
+		req.ClusterParameterGroupName = aws.String(d.Get("cluster_parameter_group_name").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("automated_snapshot_retention_period") {
+		req.AutomatedSnapshotRetentionPeriod = aws.Int64(int64(d.Get("automated_snapshot_retention_period").(int)))
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_maintenance_window") {
+		req.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("cluster_version") {
+		req.ClusterVersion = aws.String(d.Get("cluster_version").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("allow_version_upgrade") {
+		req.AllowVersionUpgrade = aws.Bool(d.Get("allow_version_upgrade").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("publicly_accessible") {
+		req.PubliclyAccessible = aws.Bool(d.Get("publicly_accessible").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("enhanced_vpc_routing") {
+		req.EnhancedVpcRouting = aws.Bool(d.Get("enhanced_vpc_routing").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("cluster_type") {
+		req.ClusterType = aws.String(d.Get("cluster_type").(string))
+		requestUpdate = true
+	}
+	if d.HasChange("node_type") {
+		req.NodeType = aws.String(d.Get("node_type").(string))
+		requestUpdate = true
+	}
+	if d.HasChange("number_of_nodes") {
+		if d.Get("cluster_type").(string) == "multi-node" {
+			req.NumberOfNodes = aws.Int64(int64(d.Get("number_of_nodes").(int)))
+			requestUpdate = true
+		}
+	}
+
+	if d.HasChange("elastic_ip") {
+		req.ElasticIp = aws.String(d.Get("elastic_ip").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("availability_zone_relocation_enabled") {
+		req.AvailabilityZoneRelocation = aws.Bool(d.Get("availability_zone_relocation_enabled").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("availability_zone") {
+		req.AvailabilityZone = aws.String(d.Get("availability_zone").(string))
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		log.Printf("[INFO] Modifying Redshift Cluster: %s", d.Id())
+		_, err := conn.ModifyCluster(req)
+		if err != nil {
+			return fmt.Errorf("error modifying Redshift Cluster (%s): %w", d.Id(), err)
+		}
+
+		if err := waitForRedshiftClusterUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Redshift Cluster (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	// When iam_roles is left unset in configuration, ownership of the
+	// cluster's IAM role associations is handed off to
+	// aws_redshift_cluster_iam_roles, so leave them untouched here. An
+	// explicitly empty list, by contrast, means this resource should manage
+	// the (empty) set.
+	if d.HasChange("iam_roles") && !d.GetRawConfig().GetAttr("iam_roles").IsNull() {
+		o, n := d.GetChange("iam_roles")
+		if o == nil {
+			o = new(schema.Set)
+		}
+		if n == nil {
+			n = new(schema.Set)
+		}
+
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		removeIams := os.Difference(ns)
+		addIams := ns.Difference(os)
+
+		log.Printf("[INFO] Building Redshift Modify Cluster IAM Role Input")
+		req := &redshift.ModifyClusterIamRolesInput{
+			ClusterIdentifier: aws.String(d.Id()),
+			AddIamRoles:       expandStringSet(addIams),
+			RemoveIamRoles:    expandStringSet(removeIams),
+		}
+
+		log.Printf("[INFO] Modifying Redshift Cluster IAM Roles: %s", d.Id())
+		_, err := conn.ModifyClusterIamRoles(req)
+		if err != nil {
+			return fmt.Errorf("error modifying Redshift Cluster (%s) IAM roles: %w", d.Id(), err)
+		}
+
+		if err := waitForRedshiftClusterUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Redshift Cluster (%s) IAM role update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("logging") {
+		if v, ok := d.GetOk("logging"); ok {
+			loggingConfig := v.([]interface{})[0].(map[string]interface{})
+			if loggingConfig["enable"].(bool) {
+				if err := enableRedshiftClusterLogging(conn, d.Id(), loggingConfig); err != nil {
+					return err
+				}
+			} else {
+				if err := disableRedshiftClusterLogging(conn, d.Id()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if d.HasChange("snapshot_copy") {
+		o, n := d.GetChange("snapshot_copy")
+		oList, nList := o.([]interface{}), n.([]interface{})
+
+		switch {
+		case len(oList) == 0 && len(nList) > 0:
+			if err := enableRedshiftClusterSnapshotCopy(conn, d); err != nil {
+				return err
+			}
+		case len(oList) > 0 && len(nList) == 0:
+			_, err := conn.DisableSnapshotCopy(&redshift.DisableSnapshotCopyInput{
+				ClusterIdentifier: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("error disabling Redshift Cluster (%s) snapshot copy: %w", d.Id(), err)
+			}
+		default:
+			oldSC, newSC := oList[0].(map[string]interface{}), nList[0].(map[string]interface{})
+			if oldSC["destination_region"] != newSC["destination_region"] || oldSC["grant_name"] != newSC["grant_name"] {
+				// AWS only lets the destination region or grant be changed
+				// by disabling and re-enabling snapshot copy.
+				_, err := conn.DisableSnapshotCopy(&redshift.DisableSnapshotCopyInput{
+					ClusterIdentifier: aws.String(d.Id()),
+				})
+				if err != nil {
+					return fmt.Errorf("error disabling Redshift Cluster (%s) snapshot copy: %w", d.Id(), err)
+				}
+				if err := enableRedshiftClusterSnapshotCopy(conn, d); err != nil {
+					return err
+				}
+			} else {
+				if err := modifyRedshiftClusterSnapshotCopyRetentionPeriod(conn, d.Id(), oldSC, newSC); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if d.HasChange("snapshot_schedule") {
+		if v, ok := d.GetOk("snapshot_schedule"); ok {
+			ss := v.([]interface{})[0].(map[string]interface{})
+			if err := modifyRedshiftClusterSnapshotSchedule(conn, d.Id(), ss["schedule_identifier"].(string), false); err != nil {
+				return err
+			}
+		} else {
+			o, _ := d.GetChange("snapshot_schedule")
+			if old := o.([]interface{}); len(old) > 0 {
+				oldSchedule := old[0].(map[string]interface{})
+				if err := modifyRedshiftClusterSnapshotSchedule(conn, d.Id(), oldSchedule["schedule_identifier"].(string), true); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if d.HasChange("deferred_maintenance_window") {
+		if v, ok := d.GetOk("deferred_maintenance_window"); ok {
+			dmw := v.([]interface{})[0].(map[string]interface{})
+			if err := deferRedshiftClusterMaintenance(conn, d.Id(), dmw); err != nil {
+				return err
+			}
+		} else {
+			o, _ := d.GetChange("deferred_maintenance_window")
+			oldList := o.([]interface{})
+			if len(oldList) > 0 {
+				identifier := oldList[0].(map[string]interface{})["defer_maintenance_identifier"].(string)
+				if err := cancelRedshiftClusterDeferredMaintenance(conn, d.Id(), identifier); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := keyvaluetags.RedshiftUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Redshift Cluster (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRedshiftClusterRead(d, meta)
+}
+
+func resourceAwsRedshiftClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+	log.Printf("[DEBUG] Redshift Cluster delete: %s", d.Id())
+
+	deleteOpts := redshift.DeleteClusterInput{
+		ClusterIdentifier: aws.String(d.Id()),
+	}
+
+	skipFinalSnapshot := d.Get("skip_final_snapshot").(bool)
+	deleteOpts.SkipFinalClusterSnapshot = aws.Bool(skipFinalSnapshot)
+
+	if !skipFinalSnapshot {
+		if name, present := d.GetOk("final_snapshot_identifier"); present {
+			deleteOpts.FinalClusterSnapshotIdentifier = aws.String(name.(string))
+		} else {
+			return fmt.Errorf("Redshift Cluster Instance FinalSnapshotIdentifier is required when a final snapshot is required")
+		}
+	}
+
+	log.Printf("[DEBUG] Redshift Cluster delete options: %s", deleteOpts)
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteCluster(&deleteOpts)
+		if err != nil {
+			if isAWSErr(err, redshift.ErrCodeInvalidClusterStateFault, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteCluster(&deleteOpts)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Cluster (%s): %w", d.Id(), err)
+	}
+
+	if err := waitForRedshiftClusterDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Redshift Cluster (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftClusterRetrieve(id string, conn *redshift.Redshift) (*redshift.Cluster, error) {
+	resp, err := conn.DescribeClusters(&redshift.DescribeClustersInput{
+		ClusterIdentifier: aws.String(id),
+	})
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == redshift.ErrCodeClusterNotFoundFault {
+				return nil, nil
+			}
+		}
+		return nil, fmt.Errorf("error describing Redshift Cluster (%s): %w", id, err)
+	}
+
+	if len(resp.Clusters) == 0 {
+		return nil, nil
+	}
+
+	return resp.Clusters[0], nil
+}
+
+func enableRedshiftClusterLogging(conn *redshift.Redshift, id string, loggingConfig map[string]interface{}) error {
+	params := &redshift.EnableLoggingInput{
+		ClusterIdentifier: aws.String(id),
+		BucketName:        aws.String(loggingConfig["bucket_name"].(string)),
+	}
+
+	if v, ok := loggingConfig["s3_key_prefix"]; ok && v.(string) != "" {
+		params.S3KeyPrefix = aws.String(v.(string))
+	}
+
+	if _, err := conn.EnableLogging(params); err != nil {
+		return fmt.Errorf("error enabling Redshift Cluster (%s) logging: %w", id, err)
+	}
+
+	return nil
+}
+
+func disableRedshiftClusterLogging(conn *redshift.Redshift, id string) error {
+	if _, err := conn.DisableLogging(&redshift.DisableLoggingInput{
+		ClusterIdentifier: aws.String(id),
+	}); err != nil {
+		return fmt.Errorf("error disabling Redshift Cluster (%s) logging: %w", id, err)
+	}
+
+	return nil
+}
+
+func enableRedshiftClusterSnapshotCopy(conn *redshift.Redshift, d *schema.ResourceData) error {
+	sc := d.Get("snapshot_copy").([]interface{})[0].(map[string]interface{})
+
+	input := redshift.EnableSnapshotCopyInput{
+		ClusterIdentifier: aws.String(d.Id()),
+		DestinationRegion: aws.String(sc["destination_region"].(string)),
+	}
+
+	if rp, ok := sc["retention_period"]; ok {
+		input.RetentionPeriod = aws.Int64(int64(rp.(int)))
+	}
+
+	if mrp, ok := sc["manual_snapshot_retention_period"]; ok && mrp.(int) != -1 {
+		input.ManualSnapshotRetentionPeriod = aws.Int64(int64(mrp.(int)))
+	}
+
+	if gn, ok := sc["grant_name"]; ok && gn.(string) != "" {
+		input.SnapshotCopyGrantName = aws.String(gn.(string))
+	}
+
+	_, err := conn.EnableSnapshotCopy(&input)
+	if err != nil {
+		return fmt.Errorf("error enabling Redshift Cluster (%s) snapshot copy: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// modifyRedshiftClusterSnapshotCopyRetentionPeriod issues a
+// ModifySnapshotCopyRetentionPeriod call for whichever of the automated and
+// manual retention periods actually changed, since the API models them as
+// independent settings.
+func modifyRedshiftClusterSnapshotCopyRetentionPeriod(conn *redshift.Redshift, id string, oldSC, newSC map[string]interface{}) error {
+	if oldSC["retention_period"] != newSC["retention_period"] {
+		_, err := conn.ModifySnapshotCopyRetentionPeriod(&redshift.ModifySnapshotCopyRetentionPeriodInput{
+			ClusterIdentifier: aws.String(id),
+			RetentionPeriod:   aws.Int64(int64(newSC["retention_period"].(int))),
+			Manual:            aws.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying Redshift Cluster (%s) snapshot copy retention period: %w", id, err)
+		}
+	}
+
+	if oldSC["manual_snapshot_retention_period"] != newSC["manual_snapshot_retention_period"] {
+		_, err := conn.ModifySnapshotCopyRetentionPeriod(&redshift.ModifySnapshotCopyRetentionPeriodInput{
+			ClusterIdentifier: aws.String(id),
+			RetentionPeriod:   aws.Int64(int64(newSC["manual_snapshot_retention_period"].(int))),
+			Manual:            aws.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying Redshift Cluster (%s) manual snapshot copy retention period: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftClusterSetState(conn *redshift.Redshift, d *schema.ResourceData) error {
+	switch d.Get("state").(string) {
+	case "paused":
+		_, err := conn.PauseCluster(&redshift.PauseClusterInput{
+			ClusterIdentifier: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error pausing Redshift Cluster (%s): %w", d.Id(), err)
+		}
+
+		if err := waitForRedshiftClusterState(conn, d.Id(), "paused", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Redshift Cluster (%s) to pause: %w", d.Id(), err)
+		}
+	case "available":
+		_, err := conn.ResumeCluster(&redshift.ResumeClusterInput{
+			ClusterIdentifier: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error resuming Redshift Cluster (%s): %w", d.Id(), err)
+		}
+
+		if err := waitForRedshiftClusterState(conn, d.Id(), "available", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Redshift Cluster (%s) to resume: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func waitForRedshiftClusterState(conn *redshift.Redshift, id, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "paused", "pausing", "resuming", "resizing", "modifying"},
+		Target:     []string{target},
+		Refresh:    redshiftClusterStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceAwsRedshiftClusterValidateRestoreFromSnapshot(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// snapshot_identifier may reference a snapshot created in the same apply.
+	if !d.NewValueKnown("snapshot_identifier") {
+		return nil
+	}
+
+	if d.Get("snapshot_identifier").(string) == "" {
+		if d.Get("master_username").(string) == "" {
+			return fmt.Errorf("master_username is required unless restoring from a snapshot_identifier")
+		}
+		return nil
+	}
+
+	if d.Get("owner_account").(string) != "" && d.Get("snapshot_cluster_identifier").(string) == "" {
+		return fmt.Errorf("snapshot_cluster_identifier is required when restoring a snapshot owned by another account (owner_account)")
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftClusterValidateAvailabilityZoneRelocation(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("availability_zone_relocation_enabled").(bool) {
+		return nil
+	}
+
+	if d.Get("publicly_accessible").(bool) {
+		return fmt.Errorf("availability_zone_relocation_enabled cannot be true when publicly_accessible is true")
+	}
+
+	nodeType := d.Get("node_type").(string)
+	if !strings.HasPrefix(nodeType, "ra3.") {
+		return fmt.Errorf("availability_zone_relocation_enabled requires an RA3 node_type, got %q", nodeType)
+	}
+
+	return nil
+}
+
+func modifyRedshiftClusterSnapshotSchedule(conn *redshift.Redshift, clusterID, scheduleID string, disassociate bool) error {
+	input := &redshift.ModifyClusterSnapshotScheduleInput{
+		ClusterIdentifier:    aws.String(clusterID),
+		ScheduleIdentifier:   aws.String(scheduleID),
+		DisassociateSchedule: aws.Bool(disassociate),
+	}
+
+	_, err := conn.ModifyClusterSnapshotSchedule(input)
+	if err != nil {
+		return fmt.Errorf("error modifying Redshift Cluster (%s) snapshot schedule: %w", clusterID, err)
+	}
+
+	return nil
+}
+
+func deferRedshiftClusterMaintenance(conn *redshift.Redshift, clusterID string, dmw map[string]interface{}) error {
+	input := &redshift.ModifyClusterMaintenanceInput{
+		ClusterIdentifier: aws.String(clusterID),
+		DeferMaintenance:  aws.Bool(true),
+	}
+
+	if v, ok := dmw["defer_maintenance_start_time"]; ok && v.(string) != "" {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing defer_maintenance_start_time: %w", err)
+		}
+		input.DeferMaintenanceStartTime = aws.Time(t)
+	}
+
+	if v, ok := dmw["defer_maintenance_end_time"]; ok && v.(string) != "" {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing defer_maintenance_end_time: %w", err)
+		}
+		input.DeferMaintenanceEndTime = aws.Time(t)
+	}
+
+	_, err := conn.ModifyClusterMaintenance(input)
+	if err != nil {
+		return fmt.Errorf("error deferring Redshift Cluster (%s) maintenance: %w", clusterID, err)
+	}
+
+	return nil
+}
+
+func cancelRedshiftClusterDeferredMaintenance(conn *redshift.Redshift, clusterID, identifier string) error {
+	input := &redshift.ModifyClusterMaintenanceInput{
+		ClusterIdentifier:          aws.String(clusterID),
+		DeferMaintenanceIdentifier: aws.String(identifier),
+		DeferMaintenance:           aws.Bool(false),
+	}
+
+	_, err := conn.ModifyClusterMaintenance(input)
+	if err != nil {
+		return fmt.Errorf("error canceling Redshift Cluster (%s) deferred maintenance: %w", clusterID, err)
+	}
+
+	return nil
+}
+
+func flattenRedshiftDeferredMaintenanceWindows(dmws []*redshift.DeferredMaintenanceWindow) []map[string]interface{} {
+	if len(dmws) == 0 {
+		return nil
+	}
+
+	dmw := dmws[0]
+	m := map[string]interface{}{
+		"defer_maintenance_identifier": aws.StringValue(dmw.DeferMaintenanceIdentifier),
+	}
+
+	if dmw.DeferMaintenanceStartTime != nil {
+		m["defer_maintenance_start_time"] = dmw.DeferMaintenanceStartTime.Format(time.RFC3339)
+	}
+
+	if dmw.DeferMaintenanceEndTime != nil {
+		m["defer_maintenance_end_time"] = dmw.DeferMaintenanceEndTime.Format(time.RFC3339)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenRedshiftLoggingStatus(ls *redshift.LoggingStatus) []map[string]interface{} {
+	m := map[string]interface{}{
+		"enable": aws.BoolValue(ls.LoggingEnabled),
+	}
+
+	if ls.BucketName != nil {
+		m["bucket_name"] = aws.StringValue(ls.BucketName)
+	}
+
+	if ls.S3KeyPrefix != nil {
+		m["s3_key_prefix"] = aws.StringValue(ls.S3KeyPrefix)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenRedshiftSnapshotCopyStatus(scs *redshift.ClusterSnapshotCopyStatus) []map[string]interface{} {
+	m := map[string]interface{}{
+		"destination_region":               aws.StringValue(scs.DestinationRegion),
+		"retention_period":                 aws.Int64Value(scs.RetentionPeriod),
+		"manual_snapshot_retention_period": aws.Int64Value(scs.ManualSnapshotRetentionPeriod),
+		"grant_name":                       aws.StringValue(scs.SnapshotCopyGrantName),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenRedshiftClusterNodes(nodes []*redshift.ClusterNode) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(nodes))
+	for _, n := range nodes {
+		out = append(out, map[string]interface{}{
+			"node_role":          aws.StringValue(n.NodeRole),
+			"private_ip_address": aws.StringValue(n.PrivateIPAddress),
+			"public_ip_address":  aws.StringValue(n.PublicIPAddress),
+		})
+	}
+	return out
+}
+
+func redshiftClusterStateRefreshFunc(conn *redshift.Redshift, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rsc, err := resourceAwsRedshiftClusterRetrieve(id, conn)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if rsc == nil {
+			return 42, "destroyed", nil
+		}
+
+		if rsc.ClusterStatus != nil && *rsc.ClusterStatus == "available" && rsc.PendingModifiedValues != nil {
+			pmv := rsc.PendingModifiedValues
+			if pmv.MasterUserPassword != nil || pmv.NodeType != nil || pmv.NumberOfNodes != nil ||
+				pmv.ClusterType != nil || pmv.ClusterVersion != nil || pmv.AutomatedSnapshotRetentionPeriod != nil ||
+				pmv.ClusterIdentifier != nil || pmv.PubliclyAccessible != nil || pmv.EnhancedVpcRouting != nil ||
+				pmv.MaintenanceTrackName != nil || pmv.EncryptionType != nil {
+				return rsc, "modifying", nil
+			}
+		}
+
+		return rsc, aws.StringValue(rsc.ClusterStatus), nil
+	}
+}
+
+func waitForRedshiftClusterCreation(conn *redshift.Redshift, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "backing-up", "modifying"},
+		Target:     []string{"available"},
+		Refresh:    redshiftClusterStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func waitForRedshiftClusterUpdate(conn *redshift.Redshift, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "deleting", "rebooting", "resizing", "renaming", "modifying"},
+		Target:     []string{"available"},
+		Refresh:    redshiftClusterStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func waitForRedshiftClusterDeletion(conn *redshift.Redshift, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "creating", "deleting", "rebooting", "resizing", "renaming", "final-snapshot"},
+		Target:     []string{"destroyed"},
+		Refresh:    redshiftClusterStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const redshiftClusterIamRolesUpdateTimeout = 75 * time.Minute
+
+// resourceAwsRedshiftClusterIamRoles manages a non-exclusive association
+// between a Redshift cluster and a set of IAM roles, mirroring the split
+// between aws_iam_role_policy_attachment and inline policies: multiple
+// instances of this resource (or modules) can each own a disjoint subset of
+// a cluster's IAM roles without fighting over the roles they don't track.
+func resourceAwsRedshiftClusterIamRoles() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftClusterIamRolesCreate,
+		Read:   resourceAwsRedshiftClusterIamRolesRead,
+		Update: resourceAwsRedshiftClusterIamRolesUpdate,
+		Delete: resourceAwsRedshiftClusterIamRolesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"iam_role_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsRedshiftClusterIamRolesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	clusterID := d.Get("cluster_identifier").(string)
+
+	if err := modifyRedshiftClusterIamRolesDelta(conn, clusterID, nil, d.Get("iam_role_arns").(*schema.Set)); err != nil {
+		return err
+	}
+
+	d.SetId(clusterID)
+
+	return resourceAwsRedshiftClusterIamRolesRead(d, meta)
+}
+
+func resourceAwsRedshiftClusterIamRolesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	rsc, err := resourceAwsRedshiftClusterRetrieve(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+
+	if rsc == nil {
+		log.Printf("[WARN] Redshift Cluster (%s) not found, removing aws_redshift_cluster_iam_roles from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_identifier", rsc.ClusterIdentifier)
+
+	current := make(map[string]bool, len(rsc.IamRoles))
+	for _, r := range rsc.IamRoles {
+		current[aws.StringValue(r.IamRoleArn)] = true
+	}
+
+	tracked := &schema.Set{F: schema.HashString}
+	for _, v := range d.Get("iam_role_arns").(*schema.Set).List() {
+		if current[v.(string)] {
+			tracked.Add(v)
+		}
+	}
+
+	if err := d.Set("iam_role_arns", tracked); err != nil {
+		return fmt.Errorf("error setting iam_role_arns: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftClusterIamRolesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if d.HasChange("iam_role_arns") {
+		o, n := d.GetChange("iam_role_arns")
+		if err := modifyRedshiftClusterIamRolesDelta(conn, d.Id(), o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsRedshiftClusterIamRolesRead(d, meta)
+}
+
+func resourceAwsRedshiftClusterIamRolesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if err := modifyRedshiftClusterIamRolesDelta(conn, d.Id(), d.Get("iam_role_arns").(*schema.Set), nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// modifyRedshiftClusterIamRolesDelta issues only the Add/Remove calls needed
+// to move a cluster's IAM roles from old to new, leaving roles this resource
+// doesn't track (i.e. not present in either set) alone.
+func modifyRedshiftClusterIamRolesDelta(conn *redshift.Redshift, clusterID string, oldRoles, newRoles *schema.Set) error {
+	if oldRoles == nil {
+		oldRoles = &schema.Set{F: schema.HashString}
+	}
+	if newRoles == nil {
+		newRoles = &schema.Set{F: schema.HashString}
+	}
+
+	add := expandStringSet(newRoles.Difference(oldRoles))
+	remove := expandStringSet(oldRoles.Difference(newRoles))
+
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	input := &redshift.ModifyClusterIamRolesInput{
+		ClusterIdentifier: aws.String(clusterID),
+	}
+
+	if len(add) > 0 {
+		input.AddIamRoles = add
+	}
+
+	if len(remove) > 0 {
+		input.RemoveIamRoles = remove
+	}
+
+	log.Printf("[INFO] Modifying Redshift Cluster (%s) IAM roles: %s", clusterID, input)
+
+	// Multiple aws_redshift_cluster_iam_roles resources (or modules) can
+	// target the same cluster concurrently, each only touching its own
+	// disjoint set of roles. AWS still serializes ModifyClusterIamRoles
+	// calls per cluster and returns InvalidClusterState while another one
+	// is in flight, so retry until the cluster is free.
+	err := resource.Retry(redshiftClusterIamRolesUpdateTimeout, func() *resource.RetryError {
+		_, err := conn.ModifyClusterIamRoles(input)
+		if err != nil {
+			if isAWSErr(err, redshift.ErrCodeInvalidClusterStateFault, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.ModifyClusterIamRoles(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error modifying Redshift Cluster (%s) IAM roles: %w", clusterID, err)
+	}
+
+	return waitForRedshiftClusterUpdate(conn, clusterID, redshiftClusterIamRolesUpdateTimeout)
+}
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSRedshiftClusterIamRoles_basic(t *testing.T) {
+	var v redshift.Cluster
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterIamRolesConfig_disjoint(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					testAccCheckAWSRedshiftClusterIamRolesCount(&v, 2),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster_iam_roles.first", "iam_role_arns.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster_iam_roles.second", "iam_role_arns.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "aws_redshift_cluster_iam_roles.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRedshiftClusterIamRolesCount(v *redshift.Cluster, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(v.IamRoles) != count {
+			return fmt.Errorf("expected %d IAM roles attached to cluster, got %d", count, len(v.IamRoles))
+		}
+		return nil
+	}
+}
+
+func testAccAWSRedshiftClusterIamRolesConfig_disjoint(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_iam_role" "first" {
+  name               = "tf-redshift-iam-roles-first-%[1]d"
+  assume_role_policy = data.aws_iam_policy_document.assume.json
+}
+
+resource "aws_iam_role" "second" {
+  name               = "tf-redshift-iam-roles-second-%[1]d"
+  assume_role_policy = data.aws_iam_policy_document.assume.json
+}
+
+data "aws_iam_policy_document" "assume" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "Service"
+      identifiers = ["redshift.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%[1]d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+
+resource "aws_redshift_cluster_iam_roles" "first" {
+  cluster_identifier = aws_redshift_cluster.default.cluster_identifier
+  iam_role_arns      = [aws_iam_role.first.arn]
+}
+
+resource "aws_redshift_cluster_iam_roles" "second" {
+  cluster_identifier = aws_redshift_cluster.default.cluster_identifier
+  iam_role_arns      = [aws_iam_role.second.arn]
+}
+`, rInt))
+}
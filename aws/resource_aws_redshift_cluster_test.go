@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -263,6 +264,114 @@ func TestAccAWSRedshiftCluster_loggingEnabled(t *testing.T) {
 	})
 }
 
+func TestAccAWSRedshiftCluster_snapshotSchedule(t *testing.T) {
+	var v redshift.Cluster
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterConfig_snapshotScheduleAssociated(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttrPair(
+						"aws_redshift_cluster.default", "snapshot_schedule.0.schedule_identifier",
+						"aws_redshift_snapshot_schedule.default", "identifier"),
+				),
+			},
+			{
+				ResourceName:      "aws_redshift_cluster.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"final_snapshot_identifier",
+					"master_password",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_snapshotScheduleUpdated(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttrPair(
+						"aws_redshift_cluster.default", "snapshot_schedule.0.schedule_identifier",
+						"aws_redshift_snapshot_schedule.updated", "identifier"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_snapshotScheduleDisassociated(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "snapshot_schedule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftCluster_deferredMaintenanceWindow(t *testing.T) {
+	var v redshift.Cluster
+	rInt := acctest.RandInt()
+
+	now := time.Now().UTC()
+	start1 := now.Add(24 * time.Hour)
+	end1 := now.Add(48 * time.Hour)
+	start2 := now.Add(72 * time.Hour)
+	end2 := now.Add(96 * time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterConfig_deferredMaintenanceWindow(rInt, start1, end1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttrSet(
+						"aws_redshift_cluster.default", "deferred_maintenance_window.0.defer_maintenance_identifier"),
+				),
+			},
+			{
+				ResourceName:      "aws_redshift_cluster.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"final_snapshot_identifier",
+					"master_password",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_deferredMaintenanceWindow(rInt, start2, end2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "deferred_maintenance_window.0.defer_maintenance_start_time",
+						start2.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "deferred_maintenance_window.0.defer_maintenance_end_time",
+						end2.Format(time.RFC3339)),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_deferredMaintenanceWindowDisassociated(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "deferred_maintenance_window.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSRedshiftCluster_snapshotCopy(t *testing.T) {
 	var providers []*schema.Provider
 	var v redshift.Cluster
@@ -299,6 +408,48 @@ func TestAccAWSRedshiftCluster_snapshotCopy(t *testing.T) {
 	})
 }
 
+func TestAccAWSRedshiftCluster_snapshotCopyGrant(t *testing.T) {
+	var providers []*schema.Provider
+	var v redshift.Cluster
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:        testAccErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterConfig_snapshotCopyGrant(rInt, 1, -1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttrPair("aws_redshift_cluster.default",
+						"snapshot_copy.0.destination_region", "data.aws_region.alternate", "name"),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "snapshot_copy.0.retention_period", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "snapshot_copy.0.manual_snapshot_retention_period", "-1"),
+					resource.TestCheckResourceAttrPair("aws_redshift_cluster.default",
+						"snapshot_copy.0.grant_name", "aws_redshift_snapshot_copy_grant.test", "snapshot_copy_grant_name"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_snapshotCopyGrant(rInt, 3, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "snapshot_copy.0.retention_period", "3"),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "snapshot_copy.0.manual_snapshot_retention_period", "10"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSRedshiftCluster_iamRoles(t *testing.T) {
 	var v redshift.Cluster
 
@@ -524,18 +675,279 @@ func TestAccAWSRedshiftCluster_changeAvailabilityZone(t *testing.T) {
 		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: preConfig,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &first),
-					resource.TestCheckResourceAttrPair("aws_redshift_cluster.default", "availability_zone", "data.aws_availability_zones.available", "names.0"),
-				),
-			},
-
-			{
-				Config: postConfig,
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &first),
+					resource.TestCheckResourceAttrPair("aws_redshift_cluster.default", "availability_zone", "data.aws_availability_zones.available", "names.0"),
+				),
+			},
+
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &second),
+					resource.TestCheckResourceAttrPair("aws_redshift_cluster.default", "availability_zone", "data.aws_availability_zones.available", "names.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftCluster_pauseResume(t *testing.T) {
+	var first, second, third redshift.Cluster
+
+	ri := acctest.RandInt()
+	preConfig := testAccAWSRedshiftClusterConfig_basic(ri)
+	postConfig := testAccAWSRedshiftClusterConfig_paused(ri)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &first),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.default", "state", "available"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &second),
+					testAccCheckAWSRedshiftClusterNotRecreated(&first, &second),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.default", "state", "paused"),
+				),
+			},
+			{
+				Config:   postConfig,
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_resumed(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &third),
+					testAccCheckAWSRedshiftClusterNotRecreated(&second, &third),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.default", "state", "available"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftCluster_pauseResumeModifyTags(t *testing.T) {
+	var first, second, third, fourth redshift.Cluster
+
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterConfig_basic(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &first),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.default", "state", "available"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_paused(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &second),
+					testAccCheckAWSRedshiftClusterNotRecreated(&first, &second),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.default", "state", "paused"),
+				),
+			},
+			{
+				// tagging is not a cluster-modify API call, so it should
+				// succeed even while the cluster is paused.
+				Config: testAccAWSRedshiftClusterConfig_pausedTags(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &third),
+					testAccCheckAWSRedshiftClusterNotRecreated(&second, &third),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.default", "state", "paused"),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.default", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.default", "tags.environment", "test"),
+				),
+			},
+			{
+				// but a change that requires a ModifyCluster call must be
+				// refused while paused, not silently resume the cluster.
+				Config:      testAccAWSRedshiftClusterConfig_pausedNodeType(ri),
+				ExpectError: regexp.MustCompile(`cannot modify "node_type" while Redshift Cluster .+ is paused`),
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_resumed(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &fourth),
+					testAccCheckAWSRedshiftClusterNotRecreated(&third, &fourth),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.default", "state", "available"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftCluster_availabilityZoneRelocation(t *testing.T) {
+	var first, second redshift.Cluster
+
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterConfig_availabilityZoneRelocation(ri, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &first),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "availability_zone_relocation_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_availabilityZoneRelocation(ri, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &second),
+					testAccCheckAWSRedshiftClusterNotRecreated(&first, &second),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "availability_zone_relocation_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftCluster_managedMasterPassword(t *testing.T) {
+	var v redshift.Cluster
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterConfig_managedMasterPassword(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "manage_master_user_password", "true"),
+					resource.TestCheckResourceAttrSet(
+						"aws_redshift_cluster.default", "master_password_secret_arn"),
+				),
+			},
+			{
+				ResourceName:      "aws_redshift_cluster.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"final_snapshot_identifier",
+					"manage_master_user_password",
+					"master_password",
+					"skip_final_snapshot",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftCluster_rotateManagedMasterPassword(t *testing.T) {
+	var cluster1, cluster2, cluster3 redshift.Cluster
+
+	rInt := acctest.RandInt()
+	unmanagedConfig := testAccAWSRedshiftClusterConfig_basic(rInt)
+	managedConfig := testAccAWSRedshiftClusterConfig_managedMasterPassword(rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: unmanagedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &cluster1),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "manage_master_user_password", "false"),
+				),
+			},
+			{
+				Config: managedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &cluster2),
+					testAccCheckAWSRedshiftClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "manage_master_user_password", "true"),
+				),
+			},
+			{
+				Config: unmanagedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &cluster3),
+					testAccCheckAWSRedshiftClusterNotRecreated(&cluster2, &cluster3),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.default", "manage_master_user_password", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftCluster_restoreFromSnapshot(t *testing.T) {
+	var cluster, restored redshift.Cluster
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterConfig_restoreFromSnapshot(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.source", &cluster),
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.restored", &restored),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.restored", "node_type", "dc1.large"),
+					resource.TestCheckResourceAttr("aws_redshift_cluster.restored", "database_name", "mydb"),
+					resource.TestCheckResourceAttrPair(
+						"aws_redshift_cluster.restored", "snapshot_identifier",
+						"aws_redshift_cluster_snapshot.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftCluster_restoreFromSnapshotManagedMasterPassword(t *testing.T) {
+	var cluster, restored redshift.Cluster
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterConfig_restoreFromSnapshotManagedMasterPassword(rInt),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.default", &second),
-					resource.TestCheckResourceAttrPair("aws_redshift_cluster.default", "availability_zone", "data.aws_availability_zones.available", "names.1"),
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.source", &cluster),
+					testAccCheckAWSRedshiftClusterExists("aws_redshift_cluster.restored", &restored),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_cluster.restored", "manage_master_user_password", "true"),
+					resource.TestCheckResourceAttrSet(
+						"aws_redshift_cluster.restored", "master_password_secret_arn"),
 				),
 			},
 		},
@@ -797,6 +1209,85 @@ resource "aws_redshift_cluster" "default" {
 `, rInt))
 }
 
+func testAccAWSRedshiftClusterConfig_managedMasterPassword(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  manage_master_user_password         = true
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rInt))
+}
+
+func testAccAWSRedshiftClusterConfig_restoreFromSnapshot(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "source" {
+  cluster_identifier                  = "tf-redshift-cluster-source-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+
+resource "aws_redshift_cluster_snapshot" "test" {
+  cluster_identifier  = aws_redshift_cluster.source.id
+  snapshot_identifier = "tf-acctest-snapshot-%d"
+}
+
+resource "aws_redshift_cluster" "restored" {
+  cluster_identifier          = "tf-redshift-cluster-restored-%d"
+  availability_zone           = data.aws_availability_zones.available.names[0]
+  snapshot_identifier         = aws_redshift_cluster_snapshot.test.id
+  snapshot_cluster_identifier = aws_redshift_cluster.source.id
+  node_type                   = "dc1.large"
+  allow_version_upgrade       = false
+  skip_final_snapshot         = true
+}
+`, rInt, rInt, rInt))
+}
+
+func testAccAWSRedshiftClusterConfig_restoreFromSnapshotManagedMasterPassword(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "source" {
+  cluster_identifier                  = "tf-redshift-cluster-source-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+
+resource "aws_redshift_cluster_snapshot" "test" {
+  cluster_identifier  = aws_redshift_cluster.source.id
+  snapshot_identifier = "tf-acctest-snapshot-%d"
+}
+
+resource "aws_redshift_cluster" "restored" {
+  cluster_identifier           = "tf-redshift-cluster-restored-%d"
+  availability_zone            = data.aws_availability_zones.available.names[0]
+  snapshot_identifier          = aws_redshift_cluster_snapshot.test.id
+  snapshot_cluster_identifier  = aws_redshift_cluster.source.id
+  node_type                    = "dc1.large"
+  allow_version_upgrade        = false
+  manage_master_user_password  = true
+  skip_final_snapshot          = true
+}
+`, rInt, rInt, rInt))
+}
+
 func testAccAWSRedshiftClusterConfig_encrypted(rInt int) string {
 	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
 resource "aws_kms_key" "foo" {
@@ -1048,6 +1539,122 @@ resource "aws_redshift_cluster" "default" {
 `, rInt, rInt, rInt, rInt))
 }
 
+func testAccAWSRedshiftClusterConfig_snapshotScheduleAssociated(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier  = "tf-redshift-snapshot-schedule-%d"
+  definitions = ["rate(12 hours)"]
+}
+
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+
+  snapshot_schedule {
+    schedule_identifier = aws_redshift_snapshot_schedule.default.identifier
+  }
+
+  skip_final_snapshot = true
+}
+`, rInt, rInt))
+}
+
+func testAccAWSRedshiftClusterConfig_snapshotScheduleUpdated(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier  = "tf-redshift-snapshot-schedule-%d"
+  definitions = ["rate(12 hours)"]
+}
+
+resource "aws_redshift_snapshot_schedule" "updated" {
+  identifier  = "tf-redshift-snapshot-schedule-updated-%d"
+  definitions = ["rate(6 hours)"]
+}
+
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+
+  snapshot_schedule {
+    schedule_identifier = aws_redshift_snapshot_schedule.updated.identifier
+  }
+
+  skip_final_snapshot = true
+}
+`, rInt, rInt, rInt))
+}
+
+func testAccAWSRedshiftClusterConfig_snapshotScheduleDisassociated(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier  = "tf-redshift-snapshot-schedule-%d"
+  definitions = ["rate(12 hours)"]
+}
+
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rInt, rInt))
+}
+
+func testAccAWSRedshiftClusterConfig_deferredMaintenanceWindow(rInt int, start, end time.Time) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+
+  deferred_maintenance_window {
+    defer_maintenance_start_time = %[2]q
+    defer_maintenance_end_time   = %[3]q
+  }
+
+  skip_final_snapshot = true
+}
+`, rInt, start.Format(time.RFC3339), end.Format(time.RFC3339)))
+}
+
+func testAccAWSRedshiftClusterConfig_deferredMaintenanceWindowDisassociated(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rInt))
+}
+
 func testAccAWSRedshiftClusterConfig_snapshotCopyDisabled(rInt int) string {
 	return composeConfig(
 		testAccMultipleRegionProviderConfig(2),
@@ -1096,6 +1703,51 @@ resource "aws_redshift_cluster" "default" {
 `, rInt))
 }
 
+func testAccAWSRedshiftClusterConfig_snapshotCopyGrant(rInt, retentionPeriod, manualRetentionPeriod int) string {
+	return composeConfig(
+		testAccMultipleRegionProviderConfig(2),
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_kms_key" "alternate" {
+  provider = "awsalternate"
+
+  description = "tf-redshift-snapshot-copy-grant-%[1]d"
+}
+
+resource "aws_redshift_snapshot_copy_grant" "test" {
+  provider = "awsalternate"
+
+  snapshot_copy_grant_name = "tf-redshift-snapshot-copy-grant-%[1]d"
+  kms_key_id               = aws_kms_key.alternate.key_id
+}
+
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%[1]d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  encrypted                           = true
+
+  snapshot_copy {
+    destination_region               = data.aws_region.alternate.name
+    retention_period                 = %[2]d
+    manual_snapshot_retention_period = %[3]d
+    grant_name                       = aws_redshift_snapshot_copy_grant.test.snapshot_copy_grant_name
+  }
+
+  skip_final_snapshot = true
+}
+`, rInt, retentionPeriod, manualRetentionPeriod))
+}
+
 func testAccAWSRedshiftClusterConfig_tags(rInt int) string {
 	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
 resource "aws_redshift_cluster" "default" {
@@ -1428,6 +2080,99 @@ resource "aws_redshift_cluster" "default" {
 `, rInt))
 }
 
+func testAccAWSRedshiftClusterConfig_paused(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  state                               = "paused"
+  skip_final_snapshot                 = true
+}
+`, rInt))
+}
+
+func testAccAWSRedshiftClusterConfig_resumed(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  state                               = "available"
+  skip_final_snapshot                 = true
+}
+`, rInt))
+}
+
+func testAccAWSRedshiftClusterConfig_pausedTags(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  state                               = "paused"
+  skip_final_snapshot                 = true
+
+  tags = {
+    environment = "test"
+  }
+}
+`, rInt))
+}
+
+func testAccAWSRedshiftClusterConfig_pausedNodeType(rInt int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = "tf-redshift-cluster-%d"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc2.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  state                               = "paused"
+  skip_final_snapshot                 = true
+
+  tags = {
+    environment = "test"
+  }
+}
+`, rInt))
+}
+
+func testAccAWSRedshiftClusterConfig_availabilityZoneRelocation(rInt int, enabled bool) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                   = "tf-redshift-cluster-%d"
+  database_name                        = "mydb"
+  master_username                      = "foo_test"
+  master_password                      = "Mustbe8characters"
+  node_type                            = "ra3.xlplus"
+  automated_snapshot_retention_period  = 0
+  allow_version_upgrade                = false
+  publicly_accessible                  = false
+  availability_zone_relocation_enabled = %t
+  skip_final_snapshot                  = true
+}
+`, rInt, enabled))
+}
+
 func testAccAWSRedshiftClusterConfig_updatedAvailabilityZone(rInt int) string {
 	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
 resource "aws_redshift_cluster" "default" {
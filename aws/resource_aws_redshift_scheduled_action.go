@@ -0,0 +1,363 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsRedshiftScheduledAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftScheduledActionCreate,
+		Read:   resourceAwsRedshiftScheduledActionRead,
+		Update: resourceAwsRedshiftScheduledActionUpdate,
+		Delete: resourceAwsRedshiftScheduledActionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"iam_role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"target_action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pause_cluster": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_identifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"resume_cluster": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_identifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"resize_cluster": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_identifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"cluster_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"node_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"number_of_nodes": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"classic": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsRedshiftScheduledActionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	name := d.Get("name").(string)
+
+	input := &redshift.CreateScheduledActionInput{
+		ScheduledActionName: aws.String(name),
+		Schedule:            aws.String(d.Get("schedule").(string)),
+		IamRole:             aws.String(d.Get("iam_role").(string)),
+		Enable:              aws.Bool(d.Get("enable").(bool)),
+		TargetAction:        expandRedshiftScheduledActionType(d.Get("target_action").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ScheduledActionDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing start_time: %w", err)
+		}
+		input.StartTime = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing end_time: %w", err)
+		}
+		input.EndTime = aws.Time(t)
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Scheduled Action: %s", input)
+	_, err := conn.CreateScheduledAction(input)
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Scheduled Action (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsRedshiftScheduledActionRead(d, meta)
+}
+
+func resourceAwsRedshiftScheduledActionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	action, err := resourceAwsRedshiftScheduledActionRetrieve(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+
+	if action == nil {
+		log.Printf("[WARN] Redshift Scheduled Action (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", action.ScheduledActionName)
+	d.Set("description", action.ScheduledActionDescription)
+	d.Set("enable", action.State == nil || aws.StringValue(action.State) == redshift.ScheduledActionStateActive)
+	d.Set("schedule", action.Schedule)
+	d.Set("iam_role", action.IamRole)
+
+	if action.StartTime != nil {
+		d.Set("start_time", action.StartTime.Format(time.RFC3339))
+	}
+
+	if action.EndTime != nil {
+		d.Set("end_time", action.EndTime.Format(time.RFC3339))
+	}
+
+	if err := d.Set("target_action", flattenRedshiftScheduledActionType(action.TargetAction)); err != nil {
+		return fmt.Errorf("error setting target_action: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftScheduledActionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	input := &redshift.ModifyScheduledActionInput{
+		ScheduledActionName: aws.String(d.Id()),
+		Schedule:            aws.String(d.Get("schedule").(string)),
+		IamRole:             aws.String(d.Get("iam_role").(string)),
+		Enable:              aws.Bool(d.Get("enable").(bool)),
+		TargetAction:        expandRedshiftScheduledActionType(d.Get("target_action").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ScheduledActionDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing start_time: %w", err)
+		}
+		input.StartTime = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing end_time: %w", err)
+		}
+		input.EndTime = aws.Time(t)
+	}
+
+	log.Printf("[DEBUG] Modifying Redshift Scheduled Action: %s", input)
+	_, err := conn.ModifyScheduledAction(input)
+	if err != nil {
+		return fmt.Errorf("error modifying Redshift Scheduled Action (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsRedshiftScheduledActionRead(d, meta)
+}
+
+func resourceAwsRedshiftScheduledActionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	_, err := conn.DeleteScheduledAction(&redshift.DeleteScheduledActionInput{
+		ScheduledActionName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, redshift.ErrCodeScheduledActionNotFoundFault, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Scheduled Action (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftScheduledActionRetrieve(name string, conn *redshift.Redshift) (*redshift.ScheduledAction, error) {
+	resp, err := conn.DescribeScheduledActions(&redshift.DescribeScheduledActionsInput{
+		ScheduledActionName: aws.String(name),
+	})
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == redshift.ErrCodeScheduledActionNotFoundFault {
+				return nil, nil
+			}
+		}
+		return nil, fmt.Errorf("error describing Redshift Scheduled Action (%s): %w", name, err)
+	}
+
+	if len(resp.ScheduledActions) == 0 {
+		return nil, nil
+	}
+
+	return resp.ScheduledActions[0], nil
+}
+
+func expandRedshiftScheduledActionType(l []interface{}) *redshift.ScheduledActionType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	action := &redshift.ScheduledActionType{}
+
+	if v, ok := m["pause_cluster"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		pc := v[0].(map[string]interface{})
+		action.PauseCluster = &redshift.PauseClusterMessage{
+			ClusterIdentifier: aws.String(pc["cluster_identifier"].(string)),
+		}
+	}
+
+	if v, ok := m["resume_cluster"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rc := v[0].(map[string]interface{})
+		action.ResumeCluster = &redshift.ResumeClusterMessage{
+			ClusterIdentifier: aws.String(rc["cluster_identifier"].(string)),
+		}
+	}
+
+	if v, ok := m["resize_cluster"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rs := v[0].(map[string]interface{})
+		resize := &redshift.ResizeClusterMessage{
+			ClusterIdentifier: aws.String(rs["cluster_identifier"].(string)),
+			Classic:           aws.Bool(rs["classic"].(bool)),
+		}
+
+		if v, ok := rs["cluster_type"].(string); ok && v != "" {
+			resize.ClusterType = aws.String(v)
+		}
+
+		if v, ok := rs["node_type"].(string); ok && v != "" {
+			resize.NodeType = aws.String(v)
+		}
+
+		if v, ok := rs["number_of_nodes"].(int); ok && v != 0 {
+			resize.NumberOfNodes = aws.Int64(int64(v))
+		}
+
+		action.ResizeCluster = resize
+	}
+
+	return action
+}
+
+func flattenRedshiftScheduledActionType(action *redshift.ScheduledActionType) []map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if action.PauseCluster != nil {
+		m["pause_cluster"] = []map[string]interface{}{
+			{"cluster_identifier": aws.StringValue(action.PauseCluster.ClusterIdentifier)},
+		}
+	}
+
+	if action.ResumeCluster != nil {
+		m["resume_cluster"] = []map[string]interface{}{
+			{"cluster_identifier": aws.StringValue(action.ResumeCluster.ClusterIdentifier)},
+		}
+	}
+
+	if action.ResizeCluster != nil {
+		m["resize_cluster"] = []map[string]interface{}{
+			{
+				"cluster_identifier": aws.StringValue(action.ResizeCluster.ClusterIdentifier),
+				"cluster_type":       aws.StringValue(action.ResizeCluster.ClusterType),
+				"node_type":          aws.StringValue(action.ResizeCluster.NodeType),
+				"number_of_nodes":    aws.Int64Value(action.ResizeCluster.NumberOfNodes),
+				"classic":            aws.BoolValue(action.ResizeCluster.Classic),
+			},
+		}
+	}
+
+	return []map[string]interface{}{m}
+}
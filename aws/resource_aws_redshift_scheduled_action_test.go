@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSRedshiftScheduledAction_pauseCluster(t *testing.T) {
+	var v redshift.ScheduledAction
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftScheduledActionConfig_pauseCluster(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftScheduledActionExists("aws_redshift_scheduled_action.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_scheduled_action.default", "name", rName),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_scheduled_action.default", "enable", "true"),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_scheduled_action.default", "target_action.0.pause_cluster.0.cluster_identifier", rName),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftScheduledActionConfig_pauseCluster(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftScheduledActionExists("aws_redshift_scheduled_action.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_scheduled_action.default", "enable", "false"),
+				),
+			},
+			{
+				ResourceName:      "aws_redshift_scheduled_action.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftScheduledAction_resizeCluster(t *testing.T) {
+	var v redshift.ScheduledAction
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftScheduledActionConfig_resizeCluster(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftScheduledActionExists("aws_redshift_scheduled_action.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_scheduled_action.default", "target_action.0.resize_cluster.0.node_type", "dc2.large"),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_scheduled_action.default", "target_action.0.resize_cluster.0.number_of_nodes", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRedshiftScheduledActionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_scheduled_action" {
+			continue
+		}
+
+		resp, err := conn.DescribeScheduledActions(&redshift.DescribeScheduledActionsInput{
+			ScheduledActionName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, redshift.ErrCodeScheduledActionNotFoundFault, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(resp.ScheduledActions) != 0 {
+			return fmt.Errorf("Redshift Scheduled Action %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRedshiftScheduledActionExists(n string, v *redshift.ScheduledAction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Scheduled Action ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		resp, err := conn.DescribeScheduledActions(&redshift.DescribeScheduledActionsInput{
+			ScheduledActionName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.ScheduledActions) == 0 {
+			return fmt.Errorf("Redshift Scheduled Action (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.ScheduledActions[0]
+		return nil
+	}
+}
+
+func testAccAWSRedshiftScheduledActionConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "assume" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "Service"
+      identifiers = ["scheduler.redshift.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "scheduler" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume.json
+}
+
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier                  = %[1]q
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc1.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rName)
+}
+
+func testAccAWSRedshiftScheduledActionConfig_pauseCluster(rName string, enable bool) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), testAccAWSRedshiftScheduledActionConfigBase(rName), fmt.Sprintf(`
+resource "aws_redshift_scheduled_action" "default" {
+  name     = %[1]q
+  schedule = "rate(12 hours)"
+  iam_role = aws_iam_role.scheduler.arn
+  enable   = %[2]t
+
+  target_action {
+    pause_cluster {
+      cluster_identifier = aws_redshift_cluster.default.cluster_identifier
+    }
+  }
+}
+`, rName, enable))
+}
+
+func testAccAWSRedshiftScheduledActionConfig_resizeCluster(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), testAccAWSRedshiftScheduledActionConfigBase(rName), fmt.Sprintf(`
+resource "aws_redshift_scheduled_action" "default" {
+  name     = %[1]q
+  schedule = "rate(12 hours)"
+  iam_role = aws_iam_role.scheduler.arn
+
+  target_action {
+    resize_cluster {
+      cluster_identifier = aws_redshift_cluster.default.cluster_identifier
+      node_type          = "dc2.large"
+      number_of_nodes    = 2
+      classic            = false
+    }
+  }
+}
+`, rName))
+}
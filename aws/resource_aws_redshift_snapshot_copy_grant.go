@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// resourceAwsRedshiftSnapshotCopyGrant manages the KMS grant that authorizes
+// cross-region snapshot copies to re-encrypt with a CMK in the destination
+// region. It's created with the destination region's provider (typically via
+// a provider alias) and referenced by name from the source cluster's
+// snapshot_copy block.
+func resourceAwsRedshiftSnapshotCopyGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftSnapshotCopyGrantCreate,
+		Read:   resourceAwsRedshiftSnapshotCopyGrantRead,
+		Update: resourceAwsRedshiftSnapshotCopyGrantUpdate,
+		Delete: resourceAwsRedshiftSnapshotCopyGrantDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_copy_grant_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	name := d.Get("snapshot_copy_grant_name").(string)
+
+	input := &redshift.CreateSnapshotCopyGrantInput{
+		SnapshotCopyGrantName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().RedshiftTags(); len(v) > 0 {
+		input.Tags = v
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Snapshot Copy Grant: %s", input)
+	_, err := conn.CreateSnapshotCopyGrant(input)
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Snapshot Copy Grant (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsRedshiftSnapshotCopyGrantRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	grant, err := resourceAwsRedshiftSnapshotCopyGrantRetrieve(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+
+	if grant == nil {
+		log.Printf("[WARN] Redshift Snapshot Copy Grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("snapshot_copy_grant_name", grant.SnapshotCopyGrantName)
+	d.Set("kms_key_id", grant.KmsKeyId)
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "redshift",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("snapshotcopygrant:%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	if err := d.Set("tags", keyvaluetags.RedshiftKeyValueTags(grant.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := keyvaluetags.RedshiftUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Redshift Snapshot Copy Grant (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRedshiftSnapshotCopyGrantRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	_, err := conn.DeleteSnapshotCopyGrant(&redshift.DeleteSnapshotCopyGrantInput{
+		SnapshotCopyGrantName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, redshift.ErrCodeSnapshotCopyGrantNotFoundFault, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Snapshot Copy Grant (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftSnapshotCopyGrantRetrieve(name string, conn *redshift.Redshift) (*redshift.SnapshotCopyGrant, error) {
+	resp, err := conn.DescribeSnapshotCopyGrants(&redshift.DescribeSnapshotCopyGrantsInput{
+		SnapshotCopyGrantName: aws.String(name),
+	})
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == redshift.ErrCodeSnapshotCopyGrantNotFoundFault {
+				return nil, nil
+			}
+		}
+		return nil, fmt.Errorf("error describing Redshift Snapshot Copy Grant (%s): %w", name, err)
+	}
+
+	if len(resp.SnapshotCopyGrants) == 0 {
+		return nil, nil
+	}
+
+	return resp.SnapshotCopyGrants[0], nil
+}
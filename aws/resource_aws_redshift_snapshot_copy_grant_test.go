@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSRedshiftSnapshotCopyGrant_basic(t *testing.T) {
+	var v redshift.SnapshotCopyGrant
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftSnapshotCopyGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotCopyGrantConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftSnapshotCopyGrantExists("aws_redshift_snapshot_copy_grant.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_snapshot_copy_grant.default", "snapshot_copy_grant_name", rName),
+					resource.TestCheckResourceAttrSet(
+						"aws_redshift_snapshot_copy_grant.default", "kms_key_id"),
+				),
+			},
+			{
+				ResourceName:      "aws_redshift_snapshot_copy_grant.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRedshiftSnapshotCopyGrantDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot_copy_grant" {
+			continue
+		}
+
+		resp, err := conn.DescribeSnapshotCopyGrants(&redshift.DescribeSnapshotCopyGrantsInput{
+			SnapshotCopyGrantName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, redshift.ErrCodeSnapshotCopyGrantNotFoundFault, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(resp.SnapshotCopyGrants) != 0 {
+			return fmt.Errorf("Redshift Snapshot Copy Grant %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRedshiftSnapshotCopyGrantExists(n string, v *redshift.SnapshotCopyGrant) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Snapshot Copy Grant ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		resp, err := conn.DescribeSnapshotCopyGrants(&redshift.DescribeSnapshotCopyGrantsInput{
+			SnapshotCopyGrantName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.SnapshotCopyGrants) == 0 {
+			return fmt.Errorf("Redshift Snapshot Copy Grant (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.SnapshotCopyGrants[0]
+		return nil
+	}
+}
+
+func testAccAWSRedshiftSnapshotCopyGrantConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description = "tf-test-%[1]s"
+}
+
+resource "aws_redshift_snapshot_copy_grant" "default" {
+  snapshot_copy_grant_name = %[1]q
+  kms_key_id               = aws_kms_key.test.key_id
+}
+`, rName)
+}
@@ -0,0 +1,203 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsRedshiftSnapshotSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftSnapshotScheduleCreate,
+		Read:   resourceAwsRedshiftSnapshotScheduleRead,
+		Update: resourceAwsRedshiftSnapshotScheduleUpdate,
+		Delete: resourceAwsRedshiftSnapshotScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("force_destroy", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"identifier_prefix"},
+			},
+			"identifier_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"definitions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRedshiftSnapshotScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	var identifier string
+	if v, ok := d.GetOk("identifier"); ok {
+		identifier = v.(string)
+	} else if v, ok := d.GetOk("identifier_prefix"); ok {
+		identifier = resource.PrefixedUniqueId(v.(string))
+	} else {
+		identifier = resource.UniqueId()
+	}
+
+	input := &redshift.CreateSnapshotScheduleInput{
+		ScheduleIdentifier:  aws.String(identifier),
+		ScheduleDefinitions: expandStringList(d.Get("definitions").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ScheduleDescription = aws.String(v.(string))
+	}
+
+	if v := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().RedshiftTags(); len(v) > 0 {
+		input.Tags = v
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Snapshot Schedule: %s", input)
+	_, err := conn.CreateSnapshotSchedule(input)
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Snapshot Schedule (%s): %w", identifier, err)
+	}
+
+	d.SetId(identifier)
+
+	return resourceAwsRedshiftSnapshotScheduleRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	schedule, err := resourceAwsRedshiftSnapshotScheduleRetrieve(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+
+	if schedule == nil {
+		log.Printf("[WARN] Redshift Snapshot Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("identifier", schedule.ScheduleIdentifier)
+	d.Set("description", schedule.ScheduleDescription)
+	if err := d.Set("definitions", aws.StringValueSlice(schedule.ScheduleDefinitions)); err != nil {
+		return fmt.Errorf("error setting definitions: %w", err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "redshift",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("snapshotschedule:%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	if err := d.Set("tags", keyvaluetags.RedshiftKeyValueTags(schedule.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftSnapshotScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if d.HasChange("definitions") || d.HasChange("description") {
+		input := &redshift.ModifySnapshotScheduleInput{
+			ScheduleIdentifier:  aws.String(d.Id()),
+			ScheduleDefinitions: expandStringList(d.Get("definitions").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Modifying Redshift Snapshot Schedule: %s", input)
+		_, err := conn.ModifySnapshotSchedule(input)
+		if err != nil {
+			return fmt.Errorf("error modifying Redshift Snapshot Schedule (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := keyvaluetags.RedshiftUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Redshift Snapshot Schedule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRedshiftSnapshotScheduleRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	_, err := conn.DeleteSnapshotSchedule(&redshift.DeleteSnapshotScheduleInput{
+		ScheduleIdentifier: aws.String(d.Id()),
+		ForceDelete:        aws.Bool(d.Get("force_destroy").(bool)),
+	})
+
+	if isAWSErr(err, redshift.ErrCodeSnapshotScheduleNotFoundFault, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Snapshot Schedule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftSnapshotScheduleRetrieve(id string, conn *redshift.Redshift) (*redshift.SnapshotSchedule, error) {
+	resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
+		ScheduleIdentifier: aws.String(id),
+	})
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == redshift.ErrCodeSnapshotScheduleNotFoundFault {
+				return nil, nil
+			}
+		}
+		return nil, fmt.Errorf("error describing Redshift Snapshot Schedule (%s): %w", id, err)
+	}
+
+	if len(resp.SnapshotSchedules) == 0 {
+		return nil, nil
+	}
+
+	return resp.SnapshotSchedules[0], nil
+}
@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSRedshiftSnapshotSchedule_basic(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotScheduleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftSnapshotScheduleExists("aws_redshift_snapshot_schedule.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_snapshot_schedule.default", "identifier", rName),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_snapshot_schedule.default", "definitions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "aws_redshift_snapshot_schedule.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftSnapshotSchedule_updateDefinitions(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, redshift.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotScheduleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftSnapshotScheduleExists("aws_redshift_snapshot_schedule.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_snapshot_schedule.default", "definitions.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftSnapshotScheduleConfig_updatedDefinitions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftSnapshotScheduleExists("aws_redshift_snapshot_schedule.default", &v),
+					resource.TestCheckResourceAttr(
+						"aws_redshift_snapshot_schedule.default", "definitions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRedshiftSnapshotScheduleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot_schedule" {
+			continue
+		}
+
+		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
+			ScheduleIdentifier: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, redshift.ErrCodeSnapshotScheduleNotFoundFault, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(resp.SnapshotSchedules) != 0 {
+			return fmt.Errorf("Redshift Snapshot Schedule %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRedshiftSnapshotScheduleExists(n string, v *redshift.SnapshotSchedule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Snapshot Schedule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
+			ScheduleIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.SnapshotSchedules) == 0 {
+			return fmt.Errorf("Redshift Snapshot Schedule (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.SnapshotSchedules[0]
+		return nil
+	}
+}
+
+func testAccAWSRedshiftSnapshotScheduleConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier  = %[1]q
+  description = "tf-test-redshift-snapshot-schedule"
+  definitions = ["rate(12 hours)"]
+}
+`, rName)
+}
+
+func testAccAWSRedshiftSnapshotScheduleConfig_updatedDefinitions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier  = %[1]q
+  description = "tf-test-redshift-snapshot-schedule"
+  definitions = ["rate(12 hours)", "rate(1 day)"]
+}
+`, rName)
+}